@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"godisplay/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	arrangePrimary     uint32
+	arrangeSessionOnly bool
+)
+
+var arrangeCmd = &cobra.Command{
+	Use:   "arrange <display-id>=<position> ...",
+	Short: "Position displays within the virtual desktop",
+	Long: `Arrange one or more displays within the virtual desktop and commit the
+whole layout atomically - if any display's position is rejected, the
+previous layout is left untouched.
+
+Position can be specified as:
+  - Explicit coordinates: 0,0
+  - Relative to another display: left-of:1, right-of:1, above:1, below:1
+
+Relative positions are always resolved against the referenced display's
+current on-screen position, never against another spec's new position in
+the same command. So "1=0,0 2=right-of:1" positions display 2 relative to
+display 1's position *before* this command runs, not its new (0, 0). If
+you need a layout that chains off a display's new position, apply it in
+two separate arrange commands.
+
+--primary may name a display that isn't otherwise being repositioned;
+its current position is used to translate the rest of the layout around
+it.
+
+Examples:
+  godisplay arrange 1=0,0 2=1920,0 --primary=1
+  godisplay arrange 2=right-of:1 --primary=1
+  godisplay arrange 1=0,0 --session-only`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runArrange,
+}
+
+func init() {
+	rootCmd.AddCommand(arrangeCmd)
+
+	arrangeCmd.Flags().Uint32Var(&arrangePrimary, "primary", 0,
+		"display ID to designate as the primary display")
+	arrangeCmd.Flags().BoolVar(&arrangeSessionOnly, "session-only", false,
+		"apply the layout for this session only, reverting at logout")
+}
+
+// arrangeSpec is one "<display-id>=<position>" argument, either resolved to
+// an explicit coordinate already or still relative to another display.
+type arrangeSpec struct {
+	DisplayID uint32
+	Keyword   string // "left-of", "right-of", "above", "below", or "" for explicit
+	RefID     uint32
+	X, Y      int
+}
+
+func runArrange(cmd *cobra.Command, args []string) error {
+	specs := make([]arrangeSpec, 0, len(args))
+	for _, arg := range args {
+		spec, err := parseArrangeArg(arg)
+		if err != nil {
+			return fmt.Errorf("invalid arrangement spec %q: %w", arg, err)
+		}
+		specs = append(specs, spec)
+	}
+
+	displays, err := display.GetDisplays()
+	if err != nil {
+		return fmt.Errorf("failed to get displays: %w", err)
+	}
+	byID := make(map[uint32]display.Display, len(displays))
+	for _, d := range displays {
+		byID[d.ID] = d
+	}
+	for _, s := range specs {
+		if _, ok := byID[s.DisplayID]; !ok {
+			return fmt.Errorf("display %d not found", s.DisplayID)
+		}
+	}
+
+	positions, err := resolveArrangement(specs, byID)
+	if err != nil {
+		return err
+	}
+
+	// --primary may name a display that isn't being repositioned at all
+	// (e.g. "2=right-of:1 --primary=1"), so it won't appear in positions.
+	// ApplyArrangement needs an entry for it to translate around, so supply
+	// its current, unmoved location.
+	if arrangePrimary != 0 {
+		inPositions := false
+		for _, p := range positions {
+			if p.DisplayID == arrangePrimary {
+				inPositions = true
+				break
+			}
+		}
+		if !inPositions {
+			d, ok := byID[arrangePrimary]
+			if !ok {
+				return fmt.Errorf("primary display %d not found", arrangePrimary)
+			}
+			positions = append(positions, display.Position{DisplayID: d.ID, X: d.X, Y: d.Y})
+		}
+	}
+
+	if err := display.ApplyArrangement(positions, arrangePrimary, arrangeSessionOnly); err != nil {
+		return fmt.Errorf("failed to apply arrangement: %w", err)
+	}
+
+	fmt.Println("Successfully applied display arrangement")
+	return nil
+}
+
+func parseArrangeArg(arg string) (arrangeSpec, error) {
+	parts := strings.SplitN(arg, "=", 2)
+	if len(parts) != 2 {
+		return arrangeSpec{}, fmt.Errorf("expected <display-id>=<position>")
+	}
+
+	id, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return arrangeSpec{}, fmt.Errorf("invalid display ID %q: must be a positive number", parts[0])
+	}
+	spec := arrangeSpec{DisplayID: uint32(id)}
+
+	position := parts[1]
+	for _, keyword := range []string{"left-of", "right-of", "above", "below"} {
+		prefix := keyword + ":"
+		if strings.HasPrefix(position, prefix) {
+			refID, err := strconv.ParseUint(strings.TrimPrefix(position, prefix), 10, 32)
+			if err != nil {
+				return arrangeSpec{}, fmt.Errorf("invalid reference display in %q", position)
+			}
+			spec.Keyword = keyword
+			spec.RefID = uint32(refID)
+			return spec, nil
+		}
+	}
+
+	if position == "primary" {
+		spec.X, spec.Y = 0, 0
+		return spec, nil
+	}
+
+	coords := strings.SplitN(position, ",", 2)
+	if len(coords) != 2 {
+		return arrangeSpec{}, fmt.Errorf("expected x,y coordinates or a left-of/right-of/above/below/primary keyword")
+	}
+	x, err := strconv.Atoi(coords[0])
+	if err != nil {
+		return arrangeSpec{}, fmt.Errorf("invalid x coordinate: %s", coords[0])
+	}
+	y, err := strconv.Atoi(coords[1])
+	if err != nil {
+		return arrangeSpec{}, fmt.Errorf("invalid y coordinate: %s", coords[1])
+	}
+	spec.X, spec.Y = x, y
+	return spec, nil
+}
+
+// resolveArrangement turns relative specs into absolute positions, using
+// each referenced display's current on-screen position as the anchor.
+func resolveArrangement(specs []arrangeSpec, current map[uint32]display.Display) ([]display.Position, error) {
+	positions := make([]display.Position, 0, len(specs))
+
+	for _, s := range specs {
+		if s.Keyword == "" {
+			positions = append(positions, display.Position{DisplayID: s.DisplayID, X: s.X, Y: s.Y})
+			continue
+		}
+
+		ref, ok := current[s.RefID]
+		if !ok {
+			return nil, fmt.Errorf("reference display %d not found", s.RefID)
+		}
+		this := current[s.DisplayID]
+
+		var x, y int
+		switch s.Keyword {
+		case "left-of":
+			x, y = ref.X-this.Width, ref.Y
+		case "right-of":
+			x, y = ref.X+ref.Width, ref.Y
+		case "above":
+			x, y = ref.X, ref.Y-this.Height
+		case "below":
+			x, y = ref.X, ref.Y+ref.Height
+		default:
+			return nil, fmt.Errorf("unknown position keyword %q", s.Keyword)
+		}
+
+		positions = append(positions, display.Position{DisplayID: s.DisplayID, X: x, Y: y})
+	}
+
+	return positions, nil
+}
@@ -56,6 +56,13 @@ func runList(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\nDisplay %d:\n", d.ID)
 		fmt.Printf("  Status: %s\n", getDisplayStatus(d))
 		fmt.Printf("  Current: %dx%d @ %.0fHz\n", d.Width, d.Height, d.RefreshRate)
+		fmt.Printf("  Origin: (%d, %d)\n", d.X, d.Y)
+		if d.Rotation != 0 {
+			fmt.Printf("  Rotation: %d degrees\n", d.Rotation)
+		}
+		if d.MirroredOf != 0 {
+			fmt.Printf("  Mirroring: display %d\n", d.MirroredOf)
+		}
 		if d.IsRetina() {
 			fmt.Printf("  Type: Retina (HiDPI %dx scale)\n", d.ScaleFactor)
 		}
@@ -101,7 +108,7 @@ func processModes(modes []display.Mode) []display.Mode {
 		filtered := []display.Mode{}
 
 		for _, m := range modes {
-			key := fmt.Sprintf("%dx%d@%.0f", m.PixelWidth, m.PixelHeight, m.RefreshRate)
+			key := fmt.Sprintf("%dx%d@%.0f:%d", m.PixelWidth, m.PixelHeight, m.RefreshRate, m.BitDepth)
 			if !seen[key] {
 				seen[key] = true
 				filtered = append(filtered, m)
@@ -139,7 +146,7 @@ func printModes(modes []display.Mode, currentModeNumber int) {
 			hidpi = " ⚡" // Lightning bolt for HiDPI, like RDM
 		}
 
-		fmt.Printf("%s [%d] %dx%d @ %.0fHz%s (%s)\n", prefix, m.ModeNumber, m.PixelWidth, m.PixelHeight, m.RefreshRate, hidpi, m.AspectRatio())
+		fmt.Printf("%s [%d] %dx%d @ %.0fHz%s (%s, %d-bit)\n", prefix, m.ModeNumber, m.PixelWidth, m.PixelHeight, m.RefreshRate, hidpi, m.AspectRatio(), m.BitDepth)
 	}
 }
 
@@ -167,7 +174,7 @@ func printModesGrouped(modes []display.Mode, currentModeNumber int) {
 				hidpi = " ⚡"
 			}
 
-			fmt.Printf("%s [%d] %dx%d @ %.0fHz%s\n", prefix, m.ModeNumber, m.PixelWidth, m.PixelHeight, m.RefreshRate, hidpi)
+			fmt.Printf("%s [%d] %dx%d @ %.0fHz%s (%d-bit)\n", prefix, m.ModeNumber, m.PixelWidth, m.PixelHeight, m.RefreshRate, hidpi, m.BitDepth)
 		}
 	}
 }
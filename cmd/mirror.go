@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"godisplay/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	mirrorOff         bool
+	mirrorSessionOnly bool
+)
+
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror <primary-id> <secondary-id>...",
+	Short: "Mirror one or more displays onto a primary display",
+	Long: `Make each secondary display mirror the primary display, or break
+mirroring with --off.
+
+Examples:
+  godisplay mirror 1 2
+  godisplay mirror 1 2 --off`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: runMirror,
+}
+
+func init() {
+	rootCmd.AddCommand(mirrorCmd)
+
+	mirrorCmd.Flags().BoolVar(&mirrorOff, "off", false, "stop mirroring the given secondary displays")
+	mirrorCmd.Flags().BoolVar(&mirrorSessionOnly, "session-only", false,
+		"apply for this session only, reverting at logout")
+}
+
+func runMirror(cmd *cobra.Command, args []string) error {
+	primaryID, err := parseDisplayIDArg(args[0])
+	if err != nil {
+		return err
+	}
+
+	secondaryIDs := make([]uint32, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		id, err := strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid display ID '%s': must be a positive number", arg)
+		}
+		secondaryIDs = append(secondaryIDs, uint32(id))
+	}
+
+	if err := display.SetMirror(primaryID, secondaryIDs, mirrorOff, mirrorSessionOnly); err != nil {
+		return fmt.Errorf("failed to configure mirroring: %w", err)
+	}
+
+	if mirrorOff {
+		fmt.Printf("Stopped mirroring %d display(s)\n", len(secondaryIDs))
+	} else {
+		fmt.Printf("Mirroring %d display(s) onto display %d\n", len(secondaryIDs), primaryID)
+	}
+	return nil
+}
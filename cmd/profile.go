@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+
+	"godisplay/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Save and restore full display configuration profiles",
+	Long: `Snapshot every connected display's mode, origin, rotation, mirroring
+state and gamma ramp into a named profile, and reapply it later. Displays
+are matched by their vendor/product/serial fingerprint, so profiles
+survive reboots and reconnections.`,
+}
+
+var profileSaveCmd = &cobra.Command{
+	Use:   "save <name>",
+	Short: "Snapshot the current display configuration as a profile",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProfileSave,
+}
+
+var profileApplyCmd = &cobra.Command{
+	Use:   "apply <name>",
+	Short: "Reapply a saved profile",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProfileApply,
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved profiles",
+	Args:  cobra.NoArgs,
+	RunE:  runProfileList,
+}
+
+var profileDiffCmd = &cobra.Command{
+	Use:   "diff <name>",
+	Short: "Show what applying a profile would change",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runProfileDiff,
+}
+
+var profileAutoCmd = &cobra.Command{
+	Use:   "auto",
+	Short: "Apply whichever saved profile matches the connected displays",
+	Args:  cobra.NoArgs,
+	RunE:  runProfileAuto,
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileSaveCmd, profileApplyCmd, profileListCmd, profileDiffCmd, profileAutoCmd)
+}
+
+func runProfileSave(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := display.SaveProfile(name); err != nil {
+		return fmt.Errorf("failed to save profile %q: %w", name, err)
+	}
+	fmt.Printf("Saved profile %q\n", name)
+	return nil
+}
+
+func runProfileApply(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if err := display.ApplyProfile(name); err != nil {
+		return fmt.Errorf("failed to apply profile %q: %w", name, err)
+	}
+	fmt.Printf("Applied profile %q\n", name)
+	return nil
+}
+
+func runProfileList(cmd *cobra.Command, args []string) error {
+	names, err := display.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("failed to list profiles: %w", err)
+	}
+	if len(names) == 0 {
+		fmt.Println("No saved profiles")
+		return nil
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+func runProfileDiff(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	diffs, err := display.DiffProfile(name)
+	if err != nil {
+		return fmt.Errorf("failed to diff profile %q: %w", name, err)
+	}
+	if len(diffs) == 0 {
+		fmt.Printf("Profile %q matches the current configuration\n", name)
+		return nil
+	}
+	for _, d := range diffs {
+		fmt.Println(d)
+	}
+	return nil
+}
+
+func runProfileAuto(cmd *cobra.Command, args []string) error {
+	name, err := display.AutoProfile()
+	if err != nil {
+		return fmt.Errorf("failed to find a matching profile: %w", err)
+	}
+	if err := display.ApplyProfile(name); err != nil {
+		return fmt.Errorf("failed to apply profile %q: %w", name, err)
+	}
+	fmt.Printf("Applied profile %q\n", name)
+	return nil
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"godisplay/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+var rotateCmd = &cobra.Command{
+	Use:   "rotate <display-id> <0|90|180|270>",
+	Short: "Rotate a display",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runRotate,
+}
+
+func init() {
+	rootCmd.AddCommand(rotateCmd)
+}
+
+func runRotate(cmd *cobra.Command, args []string) error {
+	displayID, err := parseDisplayIDArg(args[0])
+	if err != nil {
+		return err
+	}
+
+	degrees, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid rotation '%s': must be 0, 90, 180, or 270", args[1])
+	}
+
+	if err := display.Rotate(displayID, degrees); err != nil {
+		return fmt.Errorf("failed to rotate display %d: %w", displayID, err)
+	}
+
+	fmt.Printf("Rotated display %d to %d degrees\n", displayID, degrees)
+	return nil
+}
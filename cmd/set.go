@@ -24,13 +24,25 @@ Resolution can be specified as:
 Examples:
   godisplay set 1 1920x1080
   godisplay set 1 1920x1080@120
-  godisplay set 2 42  # Use mode number directly`,
+  godisplay set 2 42  # Use mode number directly
+  godisplay set 1 1920x1080 --bit-depth=10
+  godisplay set 1 2560x1440@120 --closest  # nearest available mode`,
 	Args: cobra.ExactArgs(2),
 	RunE: runSet,
 }
 
+var (
+	bitDepth    int
+	wantClosest bool
+)
+
 func init() {
 	rootCmd.AddCommand(setCmd)
+
+	setCmd.Flags().IntVar(&bitDepth, "bit-depth", 0,
+		"require a mode with this many bits per channel (e.g. 10 for HDR-capable modes)")
+	setCmd.Flags().BoolVar(&wantClosest, "closest", false,
+		"pick the closest available mode instead of requiring an exact match")
 }
 
 func runSet(cmd *cobra.Command, args []string) error {
@@ -108,49 +120,25 @@ func parseResolutionSpec(spec string, modes []display.Mode) (int, error) {
 		return 0, fmt.Errorf("mode number %d not found", modeNum)
 	}
 
-	// Parse resolution format
-	var width, height int
-	var refreshRate float64 = 0
-	var wantHiDPI bool
-
-	// Check for @2x suffix for HiDPI
-	if strings.HasSuffix(spec, "@2x") {
-		wantHiDPI = true
-		spec = strings.TrimSuffix(spec, "@2x")
-	}
-
-	// Parse WIDTHxHEIGHT[@REFRESH]
-	parts := strings.Split(spec, "@")
-	if len(parts) > 2 {
-		return 0, fmt.Errorf("invalid resolution format: %s", spec)
+	width, height, refreshRate, wantHiDPI, err := parseResolutionComponents(spec)
+	if err != nil {
+		return 0, err
 	}
 
-	if len(parts) == 2 {
-		r, err := strconv.ParseFloat(parts[1], 64)
+	if wantClosest {
+		mode, err := display.FindClosestMode(modes, display.ModeQuery{
+			Width:       width,
+			Height:      height,
+			RefreshRate: refreshRate,
+			BitDepth:    bitDepth,
+			PreferHiDPI: wantHiDPI,
+		})
 		if err != nil {
-			return 0, fmt.Errorf("invalid refresh rate: %s", parts[1])
+			return 0, err
 		}
-		refreshRate = r
+		return mode.ModeNumber, nil
 	}
 
-	// Parse WIDTHxHEIGHT
-	resParts := strings.Split(parts[0], "x")
-	if len(resParts) != 2 {
-		return 0, fmt.Errorf("invalid resolution format: %s", parts[0])
-	}
-
-	w, err := strconv.Atoi(resParts[0])
-	if err != nil {
-		return 0, fmt.Errorf("invalid width: %s", resParts[0])
-	}
-	width = w
-
-	h, err := strconv.Atoi(resParts[1])
-	if err != nil {
-		return 0, fmt.Errorf("invalid height: %s", resParts[1])
-	}
-	height = h
-
 	// Find matching mode
 	var bestMode *display.Mode
 	for i := range modes {
@@ -166,6 +154,11 @@ func parseResolutionSpec(spec string, modes []display.Mode) (int, error) {
 			continue
 		}
 
+		// Check bit depth requirement
+		if bitDepth > 0 && m.BitDepth != bitDepth {
+			continue
+		}
+
 		// Check refresh rate if specified
 		if refreshRate > 0 {
 			if int(m.RefreshRate) != int(refreshRate) {
@@ -187,3 +180,47 @@ func parseResolutionSpec(spec string, modes []display.Mode) (int, error) {
 
 	return bestMode.ModeNumber, nil
 }
+
+// parseResolutionComponents parses a WIDTHxHEIGHT[@REFRESH][@2x] spec into
+// its pieces, shared by the exact-match and --closest lookup paths.
+func parseResolutionComponents(spec string) (width, height int, refreshRate float64, wantHiDPI bool, err error) {
+	// Check for @2x suffix for HiDPI
+	if strings.HasSuffix(spec, "@2x") {
+		wantHiDPI = true
+		spec = strings.TrimSuffix(spec, "@2x")
+	}
+
+	// Parse WIDTHxHEIGHT[@REFRESH]
+	parts := strings.Split(spec, "@")
+	if len(parts) > 2 {
+		return 0, 0, 0, false, fmt.Errorf("invalid resolution format: %s", spec)
+	}
+
+	if len(parts) == 2 {
+		r, parseErr := strconv.ParseFloat(parts[1], 64)
+		if parseErr != nil {
+			return 0, 0, 0, false, fmt.Errorf("invalid refresh rate: %s", parts[1])
+		}
+		refreshRate = r
+	}
+
+	// Parse WIDTHxHEIGHT
+	resParts := strings.Split(parts[0], "x")
+	if len(resParts) != 2 {
+		return 0, 0, 0, false, fmt.Errorf("invalid resolution format: %s", parts[0])
+	}
+
+	w, parseErr := strconv.Atoi(resParts[0])
+	if parseErr != nil {
+		return 0, 0, 0, false, fmt.Errorf("invalid width: %s", resParts[0])
+	}
+	width = w
+
+	h, parseErr := strconv.Atoi(resParts[1])
+	if parseErr != nil {
+		return 0, 0, 0, false, fmt.Errorf("invalid height: %s", resParts[1])
+	}
+	height = h
+
+	return width, height, refreshRate, wantHiDPI, nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"godisplay/internal/display"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var watchOnChange string
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream display add/remove/move/mode-change events",
+	Long: `Watch registers a CoreGraphics reconfiguration callback and prints an
+event each time a display is added, removed, moved, or changes mode. It
+runs until interrupted.
+
+Examples:
+  godisplay watch
+  godisplay watch --json
+  godisplay watch --on-change=docked`,
+	Args: cobra.NoArgs,
+	RunE: runWatch,
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().StringVar(&watchOnChange, "on-change", "",
+		"profile to auto-apply whenever the connected displays match it")
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	events, stop, err := display.Watch()
+	if err != nil {
+		return fmt.Errorf("failed to start watching: %w", err)
+	}
+	defer stop()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+
+	asJSON := viper.GetBool("json")
+	encoder := json.NewEncoder(os.Stdout)
+
+	for {
+		select {
+		case event := <-events:
+			if asJSON {
+				if err := encoder.Encode(event); err != nil {
+					return fmt.Errorf("failed to encode event: %w", err)
+				}
+			} else {
+				fmt.Printf("display %d: %s\n", event.DisplayID, strings.Join(event.Flags, ", "))
+			}
+
+			if watchOnChange != "" {
+				applyOnChange(watchOnChange)
+			}
+		case <-sig:
+			return nil
+		}
+	}
+}
+
+func applyOnChange(profileName string) {
+	matches, err := display.ProfileMatchesConnected(profileName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "on-change: failed to check profile %q: %v\n", profileName, err)
+		return
+	}
+	if !matches {
+		return
+	}
+
+	if err := display.ApplyProfile(profileName); err != nil {
+		fmt.Fprintf(os.Stderr, "on-change: failed to apply profile %q: %v\n", profileName, err)
+		return
+	}
+	fmt.Printf("on-change: applied profile %q\n", profileName)
+}
@@ -0,0 +1,76 @@
+package display
+
+// #include "bridge.h"
+import "C"
+import "fmt"
+
+// Position is the desired (x, y) origin of a display within the virtual
+// desktop, in the same coordinate space CGDisplayBounds reports (origin
+// top-left, x increasing right, y increasing down).
+type Position struct {
+	DisplayID uint32
+	X         int
+	Y         int
+}
+
+// SetDisplayOrigin moves a single display to (x, y) as its own atomic
+// transaction. Most callers arranging more than one display should use
+// ApplyArrangement instead so the whole layout commits together.
+func SetDisplayOrigin(displayID uint32, x, y int, sessionOnly bool) error {
+	return ApplyArrangement([]Position{{DisplayID: displayID, X: x, Y: y}}, 0, sessionOnly)
+}
+
+// ApplyArrangement moves every display in positions to its requested origin
+// in a single CGBeginDisplayConfiguration/CGCompleteDisplayConfiguration
+// transaction, so the layout is all-or-nothing. If primary is non-zero, the
+// positions are translated so that display ends up at (0, 0) - CoreGraphics
+// treats whichever display sits at the origin as the primary display. When
+// sessionOnly is true the layout is applied with kCGConfigureForSession and
+// reverts at logout; otherwise it persists across reboots.
+func ApplyArrangement(positions []Position, primary uint32, sessionOnly bool) error {
+	if len(positions) == 0 {
+		return fmt.Errorf("no display positions given")
+	}
+
+	if primary != 0 {
+		var dx, dy int
+		found := false
+		for _, p := range positions {
+			if p.DisplayID == primary {
+				dx, dy = p.X, p.Y
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("primary display %d not present in arrangement", primary)
+		}
+		for i := range positions {
+			positions[i].X -= dx
+			positions[i].Y -= dy
+		}
+	}
+
+	config := C.begin_display_configuration()
+	if config == nil {
+		return fmt.Errorf("failed to begin display configuration transaction")
+	}
+
+	for _, p := range positions {
+		if result := C.configure_display_origin(config, C.uint32_t(p.DisplayID), C.int32_t(p.X), C.int32_t(p.Y)); result != 0 {
+			C.cancel_display_configuration(config)
+			return fmt.Errorf("failed to position display %d at (%d, %d): CoreGraphics error %d", p.DisplayID, p.X, p.Y, int(result))
+		}
+	}
+
+	sessionOnlyFlag := C.int(0)
+	if sessionOnly {
+		sessionOnlyFlag = 1
+	}
+
+	if result := C.complete_display_configuration(config, sessionOnlyFlag); result != 0 {
+		return fmt.Errorf("failed to commit display arrangement: CoreGraphics error %d", int(result))
+	}
+
+	return nil
+}
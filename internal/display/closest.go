@@ -0,0 +1,95 @@
+package display
+
+import "fmt"
+
+// Weights for the non-linear (refresh rate, bit depth) terms of the
+// closest-mode score, tuned so a few Hz or bits of difference matters far
+// less than a mismatched resolution. Mirrors GLFW's chooseVideoMode ranking.
+const (
+	closestRefreshWeight = 1.0
+	closestDepthWeight   = 100.0
+)
+
+// ModeQuery is a desired, possibly inexact, mode specification for
+// FindClosestMode.
+type ModeQuery struct {
+	Width       int
+	Height      int
+	RefreshRate float64
+	BitDepth    int
+	PreferHiDPI bool
+}
+
+// FindClosestMode ranks every mode by a weighted distance from the query in
+// (width, height, refresh, bit-depth) space and returns the best match.
+// HiDPI is a hard preference when requested, as is avoiding modes whose
+// area is smaller than the query's (a downscaled match). Ties are broken by
+// preferring native modes, then higher refresh rates.
+func FindClosestMode(modes []Mode, query ModeQuery) (Mode, error) {
+	if len(modes) == 0 {
+		return Mode{}, fmt.Errorf("no modes to match against")
+	}
+
+	targetArea := query.Width * query.Height
+	var best *Mode
+	var bestScore float64
+	var bestDownscaled bool
+
+	for i := range modes {
+		m := &modes[i]
+
+		if query.PreferHiDPI && !m.IsHiDPI {
+			continue
+		}
+
+		score := closestModeScore(*m, query, targetArea)
+		downscaled := targetArea > 0 && m.PixelWidth*m.PixelHeight < targetArea
+
+		if best == nil || (bestDownscaled && !downscaled) ||
+			(downscaled == bestDownscaled && (score < bestScore ||
+				(score == bestScore && closestModeBetterTiebreak(*m, *best)))) {
+			best = m
+			bestScore = score
+			bestDownscaled = downscaled
+		}
+	}
+
+	if best == nil {
+		return Mode{}, fmt.Errorf("no mode satisfies the requested constraints")
+	}
+	return *best, nil
+}
+
+func closestModeScore(m Mode, query ModeQuery, targetArea int) float64 {
+	dw := float64(m.PixelWidth - query.Width)
+	dh := float64(m.PixelHeight - query.Height)
+
+	score := dw*dw + dh*dh
+
+	// A query refresh/bit-depth of <= 0 means "don't care" - scoring it
+	// against 0 would bias every match toward the lowest refresh rate and
+	// bit depth available at the resolution, rather than ignoring the term.
+	if query.RefreshRate > 0 {
+		dr := m.RefreshRate - query.RefreshRate
+		score += closestRefreshWeight * dr * dr
+	}
+	if query.BitDepth > 0 {
+		dd := float64(m.BitDepth - query.BitDepth)
+		score += closestDepthWeight * dd * dd
+	}
+
+	// Penalize modes smaller than the requested area, so "~1440p" prefers
+	// upscaling-free native modes over a downscaled 1080p match.
+	if targetArea > 0 && m.PixelWidth*m.PixelHeight < targetArea {
+		score += float64(targetArea)
+	}
+
+	return score
+}
+
+func closestModeBetterTiebreak(candidate, current Mode) bool {
+	if candidate.IsNative != current.IsNative {
+		return candidate.IsNative
+	}
+	return candidate.RefreshRate > current.RefreshRate
+}
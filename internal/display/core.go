@@ -1,6 +1,6 @@
 package display
 
-// #cgo CFLAGS: -x objective-c
+// #cgo CFLAGS: -x objective-c -fobjc-arc
 // #cgo LDFLAGS: -framework CoreGraphics -framework CoreFoundation -framework IOKit -framework Foundation
 // #include "bridge.h"
 import "C"
@@ -10,15 +10,22 @@ import (
 )
 
 type Display struct {
-	ID          uint32
-	Width       int
-	Height      int
-	RefreshRate float64
-	ScaleFactor int
-	IsBuiltin   bool
-	IsOnline    bool
-	Name        string
-	ModeNumber  int
+	ID           uint32
+	Width        int
+	Height       int
+	X            int
+	Y            int
+	RefreshRate  float64
+	ScaleFactor  int
+	IsBuiltin    bool
+	IsOnline     bool
+	Name         string
+	ModeNumber   int
+	VendorID     uint32
+	ProductID    uint32
+	SerialNumber uint32
+	Rotation     int
+	MirroredOf   uint32
 }
 
 type Mode struct {
@@ -30,6 +37,8 @@ type Mode struct {
 	IsHiDPI     bool
 	IsNative    bool
 	ModeNumber  int
+	BitDepth    int
+	IOFlags     uint32
 }
 
 // GetDisplays returns all active displays
@@ -51,15 +60,22 @@ func GetDisplays() ([]Display, error) {
 
 	for i, cd := range cDisplaySlice {
 		displays[i] = Display{
-			ID:          uint32(cd.display_id),
-			Width:       int(cd.width),
-			Height:      int(cd.height),
-			RefreshRate: float64(cd.refresh_rate),
-			ScaleFactor: int(cd.scale_factor),
-			IsBuiltin:   cd.is_builtin != 0,
-			IsOnline:    cd.is_online != 0,
-			Name:        C.GoString(cd.name),
-			ModeNumber:  int(cd.mode_number),
+			ID:           uint32(cd.display_id),
+			Width:        int(cd.width),
+			Height:       int(cd.height),
+			X:            int(cd.x),
+			Y:            int(cd.y),
+			RefreshRate:  float64(cd.refresh_rate),
+			ScaleFactor:  int(cd.scale_factor),
+			IsBuiltin:    cd.is_builtin != 0,
+			IsOnline:     cd.is_online != 0,
+			Name:         C.GoString(cd.name),
+			ModeNumber:   int(cd.mode_number),
+			VendorID:     uint32(cd.vendor_id),
+			ProductID:    uint32(cd.product_id),
+			SerialNumber: uint32(cd.serial_number),
+			Rotation:     int(cd.rotation),
+			MirroredOf:   uint32(cd.mirrored_of),
 		}
 	}
 
@@ -88,6 +104,8 @@ func GetDisplayModes(displayID uint32) ([]Mode, error) {
 			IsHiDPI:     cm.is_hidpi != 0,
 			IsNative:    cm.is_native != 0,
 			ModeNumber:  int(cm.mode_number),
+			BitDepth:    int(cm.bit_depth),
+			IOFlags:     uint32(cm.io_flags),
 		}
 	}
 
@@ -0,0 +1,170 @@
+package display
+
+// #include "bridge.h"
+import "C"
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"unsafe"
+)
+
+// GammaRamp holds one transfer table per channel, as accepted by
+// CGSetDisplayTransferByTable. All three slices must be the same length.
+type GammaRamp struct {
+	Red   []float32 `json:"red"`
+	Green []float32 `json:"green"`
+	Blue  []float32 `json:"blue"`
+}
+
+// GammaFormula is the formula form of CGSetDisplayTransferByFormula: a
+// gamma/contrast/brightness triple, applied per channel.
+type GammaFormula struct {
+	Gamma      float32
+	Contrast   float32
+	Brightness float32
+}
+
+// GetGammaRamp reads the display's current transfer table.
+func GetGammaRamp(displayID uint32) (GammaRamp, error) {
+	const capacity = 256
+
+	red := make([]C.float, capacity)
+	green := make([]C.float, capacity)
+	blue := make([]C.float, capacity)
+	var sampleCount C.uint32_t
+
+	result := C.get_gamma_ramp(C.uint32_t(displayID), C.uint32_t(capacity),
+		(*C.float)(unsafe.Pointer(&red[0])),
+		(*C.float)(unsafe.Pointer(&green[0])),
+		(*C.float)(unsafe.Pointer(&blue[0])),
+		&sampleCount)
+	if result != 0 {
+		return GammaRamp{}, fmt.Errorf("failed to get gamma ramp for display %d: CoreGraphics error %d", displayID, int(result))
+	}
+
+	n := int(sampleCount)
+	ramp := GammaRamp{Red: make([]float32, n), Green: make([]float32, n), Blue: make([]float32, n)}
+	for i := 0; i < n; i++ {
+		ramp.Red[i] = float32(red[i])
+		ramp.Green[i] = float32(green[i])
+		ramp.Blue[i] = float32(blue[i])
+	}
+	return ramp, nil
+}
+
+// SetGammaRamp loads an explicit transfer table onto the display.
+func SetGammaRamp(displayID uint32, ramp GammaRamp) error {
+	if len(ramp.Red) != len(ramp.Green) || len(ramp.Red) != len(ramp.Blue) {
+		return fmt.Errorf("gamma ramp channels must have equal length, got %d/%d/%d",
+			len(ramp.Red), len(ramp.Green), len(ramp.Blue))
+	}
+	if len(ramp.Red) == 0 {
+		return fmt.Errorf("gamma ramp must not be empty")
+	}
+
+	red := make([]C.float, len(ramp.Red))
+	green := make([]C.float, len(ramp.Green))
+	blue := make([]C.float, len(ramp.Blue))
+	for i := range ramp.Red {
+		red[i] = C.float(ramp.Red[i])
+		green[i] = C.float(ramp.Green[i])
+		blue[i] = C.float(ramp.Blue[i])
+	}
+
+	result := C.set_gamma_ramp(C.uint32_t(displayID), C.uint32_t(len(ramp.Red)),
+		(*C.float)(unsafe.Pointer(&red[0])),
+		(*C.float)(unsafe.Pointer(&green[0])),
+		(*C.float)(unsafe.Pointer(&blue[0])))
+	if result != 0 {
+		return fmt.Errorf("failed to set gamma ramp for display %d: CoreGraphics error %d", displayID, int(result))
+	}
+	return nil
+}
+
+// SetGammaFormula applies a gamma/contrast/brightness triple to all three
+// channels via CGSetDisplayTransferByFormula.
+func SetGammaFormula(displayID uint32, formula GammaFormula) error {
+	result := C.set_gamma_formula(C.uint32_t(displayID),
+		C.float(formula.Gamma), C.float(formula.Contrast), C.float(formula.Brightness))
+	if result != 0 {
+		return fmt.Errorf("failed to set gamma formula for display %d: CoreGraphics error %d", displayID, int(result))
+	}
+	return nil
+}
+
+// ResetGamma restores factory ColorSync calibration via
+// CGDisplayRestoreColorSyncSettings, which takes no display argument and so
+// resets every connected display, not just displayID.
+func ResetGamma(displayID uint32) error {
+	result := C.reset_gamma(C.uint32_t(displayID))
+	if result != 0 {
+		return fmt.Errorf("failed to reset gamma for display %d: CoreGraphics error %d", displayID, int(result))
+	}
+	return nil
+}
+
+// LoadGammaRampFile reads a GammaRamp from a JSON or CSV file, selected by
+// extension. CSV files hold one "red,green,blue" row per sample.
+func LoadGammaRampFile(path string) (GammaRamp, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return GammaRamp{}, fmt.Errorf("failed to read ramp file: %w", err)
+	}
+
+	if filepath.Ext(path) == ".csv" {
+		return parseGammaRampCSV(data)
+	}
+
+	var ramp GammaRamp
+	if err := json.Unmarshal(data, &ramp); err != nil {
+		return GammaRamp{}, fmt.Errorf("failed to parse ramp JSON: %w", err)
+	}
+	return ramp, nil
+}
+
+// SaveGammaRampFile writes a GammaRamp as indented JSON.
+func SaveGammaRampFile(path string, ramp GammaRamp) error {
+	data, err := json.MarshalIndent(ramp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode ramp: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write ramp file: %w", err)
+	}
+	return nil
+}
+
+func parseGammaRampCSV(data []byte) (GammaRamp, error) {
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return GammaRamp{}, fmt.Errorf("failed to parse ramp CSV: %w", err)
+	}
+
+	var ramp GammaRamp
+	for i, row := range rows {
+		if len(row) != 3 {
+			return GammaRamp{}, fmt.Errorf("ramp CSV row %d: expected 3 columns, got %d", i, len(row))
+		}
+		r, err := strconv.ParseFloat(row[0], 32)
+		if err != nil {
+			return GammaRamp{}, fmt.Errorf("ramp CSV row %d: invalid red value: %s", i, row[0])
+		}
+		g, err := strconv.ParseFloat(row[1], 32)
+		if err != nil {
+			return GammaRamp{}, fmt.Errorf("ramp CSV row %d: invalid green value: %s", i, row[1])
+		}
+		b, err := strconv.ParseFloat(row[2], 32)
+		if err != nil {
+			return GammaRamp{}, fmt.Errorf("ramp CSV row %d: invalid blue value: %s", i, row[2])
+		}
+		ramp.Red = append(ramp.Red, float32(r))
+		ramp.Green = append(ramp.Green, float32(g))
+		ramp.Blue = append(ramp.Blue, float32(b))
+	}
+	return ramp, nil
+}
@@ -0,0 +1,65 @@
+package display
+
+// #include "bridge.h"
+import "C"
+import "fmt"
+
+// SetMirror makes every display in secondaryIDs mirror primaryID, or stops
+// mirroring them if off is true, committing all of them in a single
+// CGBeginDisplayConfiguration transaction.
+func SetMirror(primaryID uint32, secondaryIDs []uint32, off bool, sessionOnly bool) error {
+	if len(secondaryIDs) == 0 {
+		return fmt.Errorf("no secondary displays given")
+	}
+
+	config := C.begin_display_configuration()
+	if config == nil {
+		return fmt.Errorf("failed to begin display configuration transaction")
+	}
+
+	master := C.uint32_t(primaryID)
+	if off {
+		master = 0 // kCGNullDirectDisplay
+	}
+
+	for _, secondaryID := range secondaryIDs {
+		if result := C.configure_display_mirror(config, C.uint32_t(secondaryID), master); result != 0 {
+			C.cancel_display_configuration(config)
+			return fmt.Errorf("failed to configure mirroring for display %d: CoreGraphics error %d", secondaryID, int(result))
+		}
+	}
+
+	sessionOnlyFlag := C.int(0)
+	if sessionOnly {
+		sessionOnlyFlag = 1
+	}
+	if result := C.complete_display_configuration(config, sessionOnlyFlag); result != 0 {
+		return fmt.Errorf("failed to commit mirror configuration: CoreGraphics error %d", int(result))
+	}
+	return nil
+}
+
+// Rotate sets a display's rotation to 0, 90, 180, or 270 degrees. Unlike
+// SetDisplayOrigin/SetMirror this has no CoreGraphics transaction to roll
+// back to - it goes through the IOFramebuffer kIOFBSetTransform selector
+// and takes effect immediately.
+func Rotate(displayID uint32, degrees int) error {
+	switch degrees {
+	case 0, 90, 180, 270:
+	default:
+		return fmt.Errorf("invalid rotation %d: must be 0, 90, 180, or 270", degrees)
+	}
+
+	result := C.rotate_display(C.uint32_t(displayID), C.int(degrees))
+	if result != 0 {
+		switch result {
+		case -1:
+			return fmt.Errorf("invalid rotation %d", degrees)
+		case 1000:
+			return fmt.Errorf("invalid display ID: %d", displayID)
+		default:
+			return fmt.Errorf("failed to rotate display %d: IOKit error %d", displayID, int(result))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,454 @@
+package display
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProfileDisplay is one display's snapshotted configuration within a
+// Profile. Displays are identified by Fingerprint rather than the ephemeral
+// CGDirectDisplayID, so a profile survives reboots and reconnections.
+type ProfileDisplay struct {
+	Fingerprint string  `yaml:"fingerprint"`
+	Width       int     `yaml:"width"`
+	Height      int     `yaml:"height"`
+	RefreshRate float64 `yaml:"refresh_rate"`
+	HiDPI       bool    `yaml:"hidpi"`
+	X           int     `yaml:"x"`
+	Y           int     `yaml:"y"`
+	Rotation    int     `yaml:"rotation"`
+	// MirrorOf is the fingerprint of the display this one mirrors, or empty
+	// if it isn't mirroring anything.
+	MirrorOf string    `yaml:"mirror_of,omitempty"`
+	Gamma    GammaRamp `yaml:"gamma,omitempty"`
+}
+
+// Profile is a named, saved snapshot of every connected display's
+// configuration.
+type Profile struct {
+	Displays []ProfileDisplay `yaml:"displays"`
+}
+
+// Fingerprint returns a stable identifier for a display's physical hardware
+// (vendor/product/serial from IOKit), used to match it across reboots and
+// reconnections instead of its ephemeral CGDirectDisplayID.
+func Fingerprint(d Display) string {
+	return fmt.Sprintf("%08x-%08x-%08x", d.VendorID, d.ProductID, d.SerialNumber)
+}
+
+// profilesDir returns $XDG_CONFIG_HOME/godisplay/profiles (falling back to
+// ~/.config/godisplay/profiles), creating it if necessary.
+func profilesDir() (string, error) {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to determine home directory: %w", err)
+		}
+		base = filepath.Join(home, ".config")
+	}
+
+	dir := filepath.Join(base, "godisplay", "profiles")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create profiles directory: %w", err)
+	}
+	return dir, nil
+}
+
+// validateProfileName rejects names that would let the profile file escape
+// the profiles directory, such as ones containing path separators or "..".
+func validateProfileName(name string) error {
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return fmt.Errorf("invalid profile name %q: must not contain path separators", name)
+	}
+	return nil
+}
+
+func profilePath(name string) (string, error) {
+	if err := validateProfileName(name); err != nil {
+		return "", err
+	}
+
+	dir, err := profilesDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".yaml"), nil
+}
+
+// fingerprintsByID maps each display's ephemeral CGDirectDisplayID to its
+// stable fingerprint, for translating ID-based fields like MirroredOf into
+// something that survives reconnection.
+func fingerprintsByID(displays []Display) map[uint32]string {
+	byID := make(map[uint32]string, len(displays))
+	for _, d := range displays {
+		byID[d.ID] = Fingerprint(d)
+	}
+	return byID
+}
+
+// captureProfile snapshots every connected display's current mode, origin,
+// rotation, mirroring state and gamma ramp.
+func captureProfile() (Profile, error) {
+	displays, err := GetDisplays()
+	if err != nil {
+		return Profile{}, fmt.Errorf("failed to get displays: %w", err)
+	}
+	fingerprintOf := fingerprintsByID(displays)
+
+	profile := Profile{Displays: make([]ProfileDisplay, 0, len(displays))}
+	for _, d := range displays {
+		gamma, err := GetGammaRamp(d.ID)
+		if err != nil {
+			return Profile{}, fmt.Errorf("failed to read gamma ramp for display %d: %w", d.ID, err)
+		}
+
+		var mirrorOf string
+		if d.MirroredOf != 0 {
+			mirrorOf = fingerprintOf[d.MirroredOf]
+		}
+
+		profile.Displays = append(profile.Displays, ProfileDisplay{
+			Fingerprint: Fingerprint(d),
+			Width:       d.Width,
+			Height:      d.Height,
+			RefreshRate: d.RefreshRate,
+			HiDPI:       d.IsRetina(),
+			X:           d.X,
+			Y:           d.Y,
+			Rotation:    d.Rotation,
+			MirrorOf:    mirrorOf,
+			Gamma:       gamma,
+		})
+	}
+	return profile, nil
+}
+
+// SaveProfile snapshots the current display configuration and writes it to
+// $XDG_CONFIG_HOME/godisplay/profiles/<name>.yaml.
+func SaveProfile(name string) error {
+	profile, err := captureProfile()
+	if err != nil {
+		return err
+	}
+
+	path, err := profilePath(name)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(profile)
+	if err != nil {
+		return fmt.Errorf("failed to encode profile: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write profile %q: %w", name, err)
+	}
+	return nil
+}
+
+// LoadProfile reads a previously saved profile by name.
+func LoadProfile(name string) (Profile, error) {
+	path, err := profilePath(name)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Profile{}, fmt.Errorf("failed to read profile %q: %w", name, err)
+	}
+
+	var profile Profile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return Profile{}, fmt.Errorf("failed to parse profile %q: %w", name, err)
+	}
+	return profile, nil
+}
+
+// ListProfiles returns the names of all saved profiles, sorted.
+func ListProfiles() ([]string, error) {
+	dir, err := profilesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profiles directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".yaml" {
+			continue
+		}
+		names = append(names, e.Name()[:len(e.Name())-len(".yaml")])
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// matchByFingerprint maps a profile's saved displays onto the currently
+// connected displays that share their fingerprint. It returns an error
+// naming any saved display that has no connected match, or that shares its
+// fingerprint with another connected display (e.g. two identical monitors
+// that both report serial number 0) since that fingerprint can't be
+// resolved to a single physical display.
+func matchByFingerprint(profile Profile, connected []Display) (map[string]Display, error) {
+	byFingerprint := make(map[string]Display, len(connected))
+	duplicated := make(map[string]bool)
+	for _, d := range connected {
+		fp := Fingerprint(d)
+		if _, ok := byFingerprint[fp]; ok {
+			duplicated[fp] = true
+		}
+		byFingerprint[fp] = d
+	}
+
+	matches := make(map[string]Display, len(profile.Displays))
+	for _, pd := range profile.Displays {
+		if duplicated[pd.Fingerprint] {
+			return nil, fmt.Errorf("multiple connected displays share fingerprint %s; cannot determine which one it applies to", pd.Fingerprint)
+		}
+		d, ok := byFingerprint[pd.Fingerprint]
+		if !ok {
+			return nil, fmt.Errorf("no connected display matches fingerprint %s", pd.Fingerprint)
+		}
+		matches[pd.Fingerprint] = d
+	}
+	return matches, nil
+}
+
+// ApplyProfile reapplies a saved profile's origins, modes, rotations, gamma
+// ramps and mirroring to their matching connected displays. Origins are
+// committed together in a single display configuration transaction; modes,
+// rotations and gamma ramps are then applied one display at a time, and
+// mirroring last, each as a separate change. The profile as a whole is not
+// applied atomically: if a later step fails, the changes already made stay
+// in place.
+func ApplyProfile(name string) error {
+	profile, err := LoadProfile(name)
+	if err != nil {
+		return err
+	}
+
+	connected, err := GetDisplays()
+	if err != nil {
+		return fmt.Errorf("failed to get displays: %w", err)
+	}
+
+	matches, err := matchByFingerprint(profile, connected)
+	if err != nil {
+		return err
+	}
+
+	positions := make([]Position, 0, len(profile.Displays))
+	for _, pd := range profile.Displays {
+		d := matches[pd.Fingerprint]
+		positions = append(positions, Position{DisplayID: d.ID, X: pd.X, Y: pd.Y})
+	}
+	if err := ApplyArrangement(positions, 0, false); err != nil {
+		return fmt.Errorf("failed to apply profile %q origins: %w", name, err)
+	}
+
+	for _, pd := range profile.Displays {
+		d := matches[pd.Fingerprint]
+
+		modes, err := GetDisplayModes(d.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get modes for display %d: %w", d.ID, err)
+		}
+		modeNumber, err := findProfileMode(modes, pd)
+		if err != nil {
+			return fmt.Errorf("display %d: %w", d.ID, err)
+		}
+		if err := SetDisplayMode(d.ID, modeNumber); err != nil {
+			return fmt.Errorf("failed to set mode for display %d: %w", d.ID, err)
+		}
+
+		if err := Rotate(d.ID, pd.Rotation); err != nil {
+			return fmt.Errorf("failed to set rotation for display %d: %w", d.ID, err)
+		}
+
+		if len(pd.Gamma.Red) > 0 {
+			if err := SetGammaRamp(d.ID, pd.Gamma); err != nil {
+				return fmt.Errorf("failed to set gamma ramp for display %d: %w", d.ID, err)
+			}
+		}
+	}
+
+	// Mirroring is applied in its own pass, after every display has its own
+	// mode set, so a display that should mirror another isn't fighting over
+	// a mode change applied to its master in the loop above.
+	for _, pd := range profile.Displays {
+		d := matches[pd.Fingerprint]
+
+		if pd.MirrorOf == "" {
+			if err := SetMirror(0, []uint32{d.ID}, true, false); err != nil {
+				return fmt.Errorf("failed to stop mirroring for display %d: %w", d.ID, err)
+			}
+			continue
+		}
+
+		master, ok := matches[pd.MirrorOf]
+		if !ok {
+			return fmt.Errorf("display %d: no connected display matches mirror master fingerprint %s", d.ID, pd.MirrorOf)
+		}
+		if err := SetMirror(master.ID, []uint32{d.ID}, false, false); err != nil {
+			return fmt.Errorf("failed to mirror display %d onto %d: %w", d.ID, master.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func findProfileMode(modes []Mode, pd ProfileDisplay) (int, error) {
+	for _, m := range modes {
+		if m.PixelWidth == pd.Width && m.PixelHeight == pd.Height &&
+			int(m.RefreshRate) == int(pd.RefreshRate) && m.IsHiDPI == pd.HiDPI {
+			return m.ModeNumber, nil
+		}
+	}
+	return 0, fmt.Errorf("no mode matching %dx%d@%.0f (hidpi=%v)", pd.Width, pd.Height, pd.RefreshRate, pd.HiDPI)
+}
+
+// DiffProfile describes, in human-readable lines, what ApplyProfile would
+// change about the current layout if the named profile were applied: origin,
+// mode, rotation and mirroring. Gamma ramps are not compared.
+func DiffProfile(name string) ([]string, error) {
+	profile, err := LoadProfile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	connected, err := GetDisplays()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get displays: %w", err)
+	}
+
+	matches, err := matchByFingerprint(profile, connected)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprintOf := fingerprintsByID(connected)
+
+	var diffs []string
+	for _, pd := range profile.Displays {
+		d := matches[pd.Fingerprint]
+
+		if d.X != pd.X || d.Y != pd.Y {
+			diffs = append(diffs, fmt.Sprintf("display %d: origin (%d, %d) -> (%d, %d)", d.ID, d.X, d.Y, pd.X, pd.Y))
+		}
+		if d.Width != pd.Width || d.Height != pd.Height || int(d.RefreshRate) != int(pd.RefreshRate) || d.IsRetina() != pd.HiDPI {
+			diffs = append(diffs, fmt.Sprintf("display %d: mode %dx%d@%.0f -> %dx%d@%.0f",
+				d.ID, d.Width, d.Height, d.RefreshRate, pd.Width, pd.Height, pd.RefreshRate))
+		}
+		if d.Rotation != pd.Rotation {
+			diffs = append(diffs, fmt.Sprintf("display %d: rotation %d -> %d", d.ID, d.Rotation, pd.Rotation))
+		}
+
+		var currentMirrorOf string
+		if d.MirroredOf != 0 {
+			currentMirrorOf = fingerprintOf[d.MirroredOf]
+		}
+		if currentMirrorOf != pd.MirrorOf {
+			switch {
+			case pd.MirrorOf == "":
+				diffs = append(diffs, fmt.Sprintf("display %d: stop mirroring", d.ID))
+			case currentMirrorOf == "":
+				diffs = append(diffs, fmt.Sprintf("display %d: start mirroring %s", d.ID, pd.MirrorOf))
+			default:
+				diffs = append(diffs, fmt.Sprintf("display %d: mirror target %s -> %s", d.ID, currentMirrorOf, pd.MirrorOf))
+			}
+		}
+	}
+	return diffs, nil
+}
+
+// ProfileMatchesConnected reports whether a saved profile's display
+// fingerprint set exactly matches the currently connected displays, for use
+// by callers (such as `watch --on-change`) that want to auto-apply a
+// specific profile rather than pick among all saved ones.
+func ProfileMatchesConnected(name string) (bool, error) {
+	profile, err := LoadProfile(name)
+	if err != nil {
+		return false, err
+	}
+
+	connected, err := GetDisplays()
+	if err != nil {
+		return false, fmt.Errorf("failed to get displays: %w", err)
+	}
+	if len(profile.Displays) != len(connected) {
+		return false, nil
+	}
+
+	connectedSet := make(map[string]bool, len(connected))
+	for _, d := range connected {
+		connectedSet[Fingerprint(d)] = true
+	}
+
+	for _, pd := range profile.Displays {
+		if !connectedSet[pd.Fingerprint] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// AutoProfile returns the name of the saved profile whose display
+// fingerprint set exactly matches the currently connected displays, or an
+// error if none or more than one match.
+func AutoProfile() (string, error) {
+	connected, err := GetDisplays()
+	if err != nil {
+		return "", fmt.Errorf("failed to get displays: %w", err)
+	}
+	connectedSet := make(map[string]bool, len(connected))
+	for _, d := range connected {
+		connectedSet[Fingerprint(d)] = true
+	}
+
+	names, err := ListProfiles()
+	if err != nil {
+		return "", err
+	}
+
+	var match string
+	for _, name := range names {
+		profile, err := LoadProfile(name)
+		if err != nil {
+			return "", err
+		}
+		if len(profile.Displays) != len(connectedSet) {
+			continue
+		}
+
+		allMatch := true
+		for _, pd := range profile.Displays {
+			if !connectedSet[pd.Fingerprint] {
+				allMatch = false
+				break
+			}
+		}
+		if !allMatch {
+			continue
+		}
+
+		if match != "" {
+			return "", fmt.Errorf("multiple profiles match the connected displays: %s, %s", match, name)
+		}
+		match = name
+	}
+
+	if match == "" {
+		return "", fmt.Errorf("no saved profile matches the connected displays")
+	}
+	return match, nil
+}
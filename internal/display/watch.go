@@ -0,0 +1,92 @@
+package display
+
+/*
+#include "bridge.h"
+#include <CoreGraphics/CoreGraphics.h>
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+)
+
+// ReconfigurationEvent is one CGDisplayRegisterReconfigurationCallback
+// notification, with its flags translated to readable strings.
+type ReconfigurationEvent struct {
+	DisplayID uint32   `json:"display_id"`
+	Flags     []string `json:"flags"`
+}
+
+var watchEvents chan ReconfigurationEvent
+
+//export handleDisplayReconfiguration
+func handleDisplayReconfiguration(displayID C.uint32_t, flags C.uint32_t) {
+	if watchEvents == nil {
+		return
+	}
+	event := ReconfigurationEvent{
+		DisplayID: uint32(displayID),
+		Flags:     translateReconfigurationFlags(uint32(flags)),
+	}
+	select {
+	case watchEvents <- event:
+	default:
+		// Drop the event rather than block the CoreGraphics callback thread.
+	}
+}
+
+func translateReconfigurationFlags(raw uint32) []string {
+	type flag struct {
+		bit   uint32
+		label string
+	}
+	flags := []flag{
+		{uint32(C.kCGDisplayBeginConfigurationFlag), "begin-configuration"},
+		{uint32(C.kCGDisplayMovedFlag), "moved"},
+		{uint32(C.kCGDisplaySetMainFlag), "set-main"},
+		{uint32(C.kCGDisplaySetModeFlag), "set-mode"},
+		{uint32(C.kCGDisplayAddFlag), "added"},
+		{uint32(C.kCGDisplayRemoveFlag), "removed"},
+		{uint32(C.kCGDisplayEnabledFlag), "enabled"},
+		{uint32(C.kCGDisplayDisabledFlag), "disabled"},
+		{uint32(C.kCGDisplayMirrorFlag), "mirror"},
+		{uint32(C.kCGDisplayUnMirrorFlag), "unmirror"},
+		{uint32(C.kCGDisplayDesktopShapeChangedFlag), "desktop-shape-changed"},
+	}
+
+	var labels []string
+	for _, f := range flags {
+		if raw&f.bit != 0 {
+			labels = append(labels, f.label)
+		}
+	}
+	return labels
+}
+
+// Watch registers a CoreGraphics display reconfiguration callback and
+// returns a channel of events plus a stop function to unregister it. The
+// callback requires an active CFRunLoop, so Watch pumps one on a dedicated
+// locked OS thread for as long as the watch is active.
+func Watch() (<-chan ReconfigurationEvent, func(), error) {
+	watchEvents = make(chan ReconfigurationEvent, 16)
+
+	if result := C.register_reconfiguration_callback(); result != 0 {
+		watchEvents = nil
+		return nil, nil, fmt.Errorf("failed to register display reconfiguration callback: CoreGraphics error %d", int(result))
+	}
+
+	loopStarted := make(chan struct{})
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		close(loopStarted)
+		C.run_event_loop()
+	}()
+	<-loopStarted
+
+	stop := func() {
+		C.unregister_reconfiguration_callback()
+		C.stop_event_loop()
+	}
+	return watchEvents, stop, nil
+}